@@ -0,0 +1,56 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const softLayerAPIEndpoint = "https://api.softlayer.com/rest/v3/"
+
+// softLayerHttpClient is the default softlayer.HttpClient: a thin
+// wrapper around net/http that authenticates with a SoftLayer API
+// username/key pair over HTTP basic auth, as the REST API expects.
+type softLayerHttpClient struct {
+	username   string
+	apiKey     string
+	httpClient *http.Client
+}
+
+func NewHttpClient(username string, apiKey string) softlayer.HttpClient {
+	return &softLayerHttpClient{
+		username:   username,
+		apiKey:     apiKey,
+		httpClient: &http.Client{},
+	}
+}
+
+func (c *softLayerHttpClient) DoRawHttpRequest(path string, requestType string, requestBody io.Reader) ([]byte, error) {
+	request, err := http.NewRequest(requestType, softLayerAPIEndpoint+path, requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	request.SetBasicAuth(c.username, c.apiKey)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= 400 {
+		return nil, fmt.Errorf("SoftLayer API request to %s failed with status %d: %s", path, response.StatusCode, string(responseBody))
+	}
+
+	return responseBody, nil
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	services "github.com/maximilien/softlayer-go/services"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// SoftLayer_Client is the concrete softlayer.Client used to reach the
+// SoftLayer REST API. Each GetSoftLayer_*_Service method lazily wires a
+// service implementation to this client's HTTP transport.
+type SoftLayer_Client struct {
+	HttpClient softlayer.HttpClient
+}
+
+// NewSoftLayer_Client builds a SoftLayer_Client authenticated against
+// the SoftLayer REST API with the given username/API key pair.
+func NewSoftLayer_Client(username string, apiKey string) *SoftLayer_Client {
+	return &SoftLayer_Client{
+		HttpClient: NewHttpClient(username, apiKey),
+	}
+}
+
+func (c *SoftLayer_Client) GetHttpClient() softlayer.HttpClient {
+	return c.HttpClient
+}
+
+func (c *SoftLayer_Client) GetSoftLayer_Ssh_Key_Service() softlayer.SoftLayer_Ssh_Key_Service {
+	return services.NewSoftLayer_Ssh_Key_Service(c)
+}
+
+func (c *SoftLayer_Client) GetSoftLayer_Product_Order_Service() softlayer.SoftLayer_Product_Order_Service {
+	return services.NewSoftLayer_Product_Order_Service(c)
+}
+
+func (c *SoftLayer_Client) GetSoftLayer_Virtual_Guest_Service() softlayer.SoftLayer_Virtual_Guest_Service {
+	return services.NewSoftLayer_Virtual_Guest_Service(c)
+}
+
+func (c *SoftLayer_Client) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service() softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service {
+	return services.NewSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service(c)
+}
+
+func (c *SoftLayer_Client) GetSoftLayer_Account_Service() softlayer.SoftLayer_Account_Service {
+	return services.NewSoftLayer_Account_Service(c)
+}
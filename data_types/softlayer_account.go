@@ -0,0 +1,11 @@
+package data_types
+
+type SoftLayer_Virtual_Disk_Image struct {
+	Id   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type SoftLayer_Network_Storage struct {
+	Id       int    `json:"id,omitempty"`
+	Username string `json:"username,omitempty"`
+}
@@ -0,0 +1,10 @@
+package data_types
+
+// SoftLayer_Operating_System is returned by
+// SoftLayer_Virtual_Guest_Service.GetOperatingSystem. Passwords holds
+// the root/administrator credentials SoftLayer generates at
+// provisioning time, used as a fallback when no SSH key was attached.
+type SoftLayer_Operating_System struct {
+	Id        int                           `json:"id,omitempty"`
+	Passwords []SoftLayer_Software_Password `json:"passwords,omitempty"`
+}
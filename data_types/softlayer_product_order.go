@@ -0,0 +1,28 @@
+package data_types
+
+// SoftLayer_Container_Product_Order_Virtual_Guest describes the order
+// container used to place (or verify) a monthly-billed virtual guest
+// order through SoftLayer_Product_Order, as opposed to the hourly path
+// exposed by SoftLayer_Virtual_Guest_Service.CreateObject.
+type SoftLayer_Container_Product_Order_Virtual_Guest struct {
+	ComplexType      string                             `json:"complexType"`
+	Location         string                             `json:"location,omitempty"`
+	PackageId        int                                `json:"packageId"`
+	Prices           []SoftLayer_Product_Item_Price     `json:"prices"`
+	Quantity         int                                `json:"quantity,omitempty"`
+	UseHourlyPricing bool                               `json:"useHourlyPricing"`
+	VirtualGuests    []SoftLayer_Virtual_Guest_Template `json:"virtualGuests"`
+}
+
+type SoftLayer_Product_Item_Price struct {
+	Id int `json:"id"`
+}
+
+// SoftLayer_Container_Product_Order_Receipt is returned by
+// SoftLayer_Product_Order.placeOrder and carries the order id needed to
+// look the resulting virtual guest(s) up once provisioning completes.
+type SoftLayer_Container_Product_Order_Receipt struct {
+	OrderId     int                                             `json:"orderId"`
+	OrderDate   string                                          `json:"orderDate"`
+	PlacedOrder SoftLayer_Container_Product_Order_Virtual_Guest `json:"placedOrder"`
+}
@@ -0,0 +1,8 @@
+package data_types
+
+// SoftLayer_Security_Ssh_Key is the REST resource name SoftLayer uses
+// for account-level SSH keys (what SoftLayer_Account_Service.GetSshKeys
+// returns). It is the same resource SoftLayer_Ssh_Key_Service manages,
+// so it is kept as an alias rather than a second, field-for-field
+// identical type that would need manual conversion at every boundary.
+type SoftLayer_Security_Ssh_Key = SoftLayer_Ssh_Key
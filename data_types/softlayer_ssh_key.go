@@ -0,0 +1,20 @@
+package data_types
+
+type SoftLayer_Ssh_Key struct {
+	Id          int    `json:"id,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Label       string `json:"label,omitempty"`
+	Notes       string `json:"notes,omitempty"`
+	CreateDate  string `json:"createDate,omitempty"`
+	ModifyDate  string `json:"modifyDate,omitempty"`
+}
+
+type SoftLayer_Software_Password struct {
+	Id         int    `json:"id,omitempty"`
+	Username   string `json:"username,omitempty"`
+	Password   string `json:"password,omitempty"`
+	Notes      string `json:"notes,omitempty"`
+	CreateDate string `json:"createDate,omitempty"`
+	ModifyDate string `json:"modifyDate,omitempty"`
+}
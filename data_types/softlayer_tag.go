@@ -0,0 +1,13 @@
+package data_types
+
+type SoftLayer_Tag struct {
+	Id   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// SoftLayer_Tag_Reference links a SoftLayer_Tag to the resource it was
+// attached to, e.g. via SoftLayer_Virtual_Guest_Service.GetTagReferences.
+type SoftLayer_Tag_Reference struct {
+	Id  int           `json:"id,omitempty"`
+	Tag SoftLayer_Tag `json:"tag,omitempty"`
+}
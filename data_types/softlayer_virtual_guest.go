@@ -0,0 +1,43 @@
+package data_types
+
+type Datacenter struct {
+	Name string `json:"name,omitempty"`
+}
+
+// SoftLayer_Virtual_Guest_Template is the template passed to
+// SoftLayer_Virtual_Guest_Service.CreateObject to provision a new,
+// hourly-billed virtual guest.
+type SoftLayer_Virtual_Guest_Template struct {
+	Hostname                     string                                               `json:"hostname"`
+	Domain                       string                                               `json:"domain"`
+	StartCpus                    int                                                  `json:"startCpus"`
+	MaxMemory                    int                                                  `json:"maxMemory"`
+	Datacenter                   Datacenter                                           `json:"datacenter"`
+	HourlyBillingFlag            bool                                                 `json:"hourlyBillingFlag"`
+	LocalDiskFlag                bool                                                 `json:"localDiskFlag"`
+	OperatingSystemReferenceCode string                                               `json:"operatingSystemReferenceCode,omitempty"`
+	BlockDeviceTemplateGroup     *SoftLayer_Virtual_Guest_Block_Device_Template_Group `json:"blockDeviceTemplateGroup,omitempty"`
+	SshKeys                      []SshKeyRef                                          `json:"sshKeys,omitempty"`
+}
+
+// SoftLayer_Virtual_Guest is the provisioned instance returned by
+// CreateObject and subsequent lookups.
+type SoftLayer_Virtual_Guest struct {
+	Id                      int    `json:"id,omitempty"`
+	Hostname                string `json:"hostname,omitempty"`
+	Domain                  string `json:"domain,omitempty"`
+	CreateDate              string `json:"createDate,omitempty"`
+	ModifyDate              string `json:"modifyDate,omitempty"`
+	PrimaryIpAddress        string `json:"primaryIpAddress,omitempty"`
+	PrimaryBackendIpAddress string `json:"primaryBackendIpAddress,omitempty"`
+}
+
+type SoftLayer_Virtual_Guest_Block_Device_Template_Group struct {
+	GlobalIdentifier string `json:"globalIdentifier,omitempty"`
+}
+
+// SshKeyRef is the minimal {id} reference SoftLayer expects embedded in
+// a virtual guest template's sshKeys array.
+type SshKeyRef struct {
+	Id int `json:"id"`
+}
@@ -0,0 +1,17 @@
+package data_types
+
+type SoftLayer_Container_Virtual_Guest_PowerState struct {
+	KeyName string `json:"keyName,omitempty"`
+}
+
+type SoftLayer_Provisioning_Version1_Transaction_Status struct {
+	Name string `json:"name,omitempty"`
+}
+
+// SoftLayer_Provisioning_Version1_Transaction is one of the entries
+// returned by SoftLayer_Virtual_Guest_Service.GetActiveTransactions
+// while a guest is being created, reloaded or upgraded.
+type SoftLayer_Provisioning_Version1_Transaction struct {
+	Id                int                                                `json:"id,omitempty"`
+	TransactionStatus SoftLayer_Provisioning_Version1_Transaction_Status `json:"transactionStatus,omitempty"`
+}
@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -10,7 +11,10 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"golang.org/x/crypto/ssh"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
+	services "github.com/maximilien/softlayer-go/services"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
 )
@@ -104,6 +108,98 @@ var _ = Describe("SoftLayer Services", func() {
 		})
 	})
 
+	Context("uses SoftLayer_Ssh_Key_Service to read, edit and re-read an ssh key", func() {
+		BeforeEach(func() {
+			err := testhelpers.FindAndDeleteTestSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := testhelpers.FindAndDeleteTestSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates an ssh key, edits its label/notes, and reads back the changes", func() {
+			sshKeyPath := os.Getenv("SOFTLAYER_GO_TEST_SSH_KEY_PATH")
+			Expect(sshKeyPath).ToNot(Equal(""), "SOFTLAYER_GO_TEST_SSH_KEY_PATH env variable is not set")
+
+			testSshKeyValue, err := ioutil.ReadFile(sshKeyPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			sshKey := datatypes.SoftLayer_Ssh_Key{
+				Key:   strings.Trim(string(testSshKeyValue), "\n"),
+				Label: testhelpers.TEST_LABEL_PREFIX,
+				Notes: testhelpers.TEST_NOTES_PREFIX,
+			}
+
+			sshKeyService, err := testhelpers.CreateSshKeyService()
+			Expect(err).ToNot(HaveOccurred())
+
+			createdSshKey, err := sshKeyService.CreateObject(sshKey)
+			Expect(err).ToNot(HaveOccurred())
+
+			//Re-read after create
+			readSshKey, err := sshKeyService.GetObject(createdSshKey.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readSshKey.Id).To(Equal(createdSshKey.Id), "id")
+			Expect(readSshKey.Label).To(Equal(sshKey.Label), "label")
+			Expect(readSshKey.Notes).To(Equal(sshKey.Notes), "notes")
+
+			//Edit label/notes
+			editedSshKey := readSshKey
+			editedSshKey.Label = testhelpers.TEST_LABEL_PREFIX + "-edited"
+			editedSshKey.Notes = testhelpers.TEST_NOTES_PREFIX + "-edited"
+
+			edited, err := sshKeyService.EditObject(createdSshKey.Id, editedSshKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			//Re-read to verify the edit stuck
+			reReadSshKey, err := sshKeyService.GetObject(createdSshKey.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reReadSshKey.Label).To(Equal(editedSshKey.Label), "label")
+			Expect(reReadSshKey.Notes).To(Equal(editedSshKey.Notes), "notes")
+
+			//Software passwords can be read back for a fresh key (the set may be empty)
+			softwarePasswords, err := sshKeyService.GetSoftwarePasswords(createdSshKey.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(softwarePasswords)).To(BeNumerically(">=", 0))
+
+			deleted, err := sshKeyService.DeleteObject(createdSshKey.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+		})
+	})
+
+	Context("uses SoftLayer_Product_Order_Service to verify a monthly-billed virtual guest order", func() {
+		It("verifies the order without placing it", func() {
+			productOrderService, err := testhelpers.CreateProductOrderService()
+			Expect(err).ToNot(HaveOccurred())
+
+			order := datatypes.SoftLayer_Container_Product_Order_Virtual_Guest{
+				PackageId:        46,
+				UseHourlyPricing: false,
+				VirtualGuests: []datatypes.SoftLayer_Virtual_Guest_Template{
+					{
+						Hostname:  "test",
+						Domain:    "softlayergo.com",
+						StartCpus: 1,
+						MaxMemory: 1024,
+						Datacenter: datatypes.Datacenter{
+							Name: "ams01",
+						},
+						LocalDiskFlag:                true,
+						OperatingSystemReferenceCode: "UBUNTU_LATEST",
+					},
+				},
+			}
+
+			receipt, err := productOrderService.VerifyOrder(order)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.PlacedOrder.PackageId).To(Equal(order.PackageId), "packageId")
+		})
+	})
+
 	Context("uses SoftLayer_Account to create and then delete a virtual guest instance", func() {
 		var (
 			TIMEOUT          time.Duration
@@ -140,18 +236,16 @@ var _ = Describe("SoftLayer Services", func() {
 			virtualGuestService, err := testhelpers.CreateVirtualGuestService()
 			Expect(err).ToNot(HaveOccurred())
 
+			waiter := testhelpers.CreateVirtualGuestWaiter(virtualGuestService, POLLING_INTERVAL)
+
 			fmt.Printf("----> creating new virtual guest\n")
 			virtualGuest, err := virtualGuestService.CreateObject(virtualGuestTemplate)
 			Expect(err).ToNot(HaveOccurred())
 			fmt.Printf("----> created virtual guest: %d\n", virtualGuest.Id)
 
 			fmt.Printf("----> waiting for virtual guest: %d, until RUNNING\n", virtualGuest.Id)
-			Eventually(func() string {
-				vgPowerState, err := virtualGuestService.GetPowerState(virtualGuest.Id)
-				Expect(err).ToNot(HaveOccurred())
-				fmt.Printf("----> virtual guest: %d, has power state: %s\n", virtualGuest.Id, vgPowerState.KeyName)
-				return vgPowerState.KeyName
-			}, TIMEOUT, POLLING_INTERVAL).Should(Equal("RUNNING"), "failed waiting for virtual guest to be RUNNING")
+			err = waiter.WaitForVirtualGuestToHavePowerState(virtualGuest.Id, "RUNNING", TIMEOUT)
+			Expect(err).ToNot(HaveOccurred(), "failed waiting for virtual guest to be RUNNING")
 
 			fmt.Printf("----> marking virtual guest with TEST:softlayer-go\n")
 			err = testhelpers.MarkVirtualGuestAsTest(virtualGuest)
@@ -159,12 +253,8 @@ var _ = Describe("SoftLayer Services", func() {
 			fmt.Printf("----> marked virtual guest with TEST:softlayer-go\n")
 
 			fmt.Printf("----> waiting for virtual guest to have no active transactions pending\n")
-			Eventually(func() int {
-				activeTransactions, err := virtualGuestService.GetActiveTransactions(virtualGuest.Id)
-				Expect(err).ToNot(HaveOccurred())
-				fmt.Printf("----> virtual guest: %d, has %d active transactions\n", virtualGuest.Id, len(activeTransactions))
-				return len(activeTransactions)
-			}, TIMEOUT, POLLING_INTERVAL).Should(Equal(0), "failed waiting for virtual guest to have no active transactions")
+			err = waiter.WaitForVirtualGuestHasNoActiveTransactions(virtualGuest.Id, TIMEOUT)
+			Expect(err).ToNot(HaveOccurred(), "failed waiting for virtual guest to have no active transactions")
 
 			fmt.Printf("----> deleting virtual guest: %d\n", virtualGuest.Id)
 			deleted, err := virtualGuestService.DeleteObject(virtualGuest.Id)
@@ -173,6 +263,235 @@ var _ = Describe("SoftLayer Services", func() {
 		})
 	})
 
+	Context("uses SoftLayer_Virtual_Guest_Service to configure and read back BOSH-style metadata tags", func() {
+		var (
+			TIMEOUT          time.Duration
+			POLLING_INTERVAL time.Duration
+		)
+
+		BeforeEach(func() {
+			TIMEOUT = 5 * time.Minute
+			POLLING_INTERVAL = 10 * time.Second
+
+			err := testhelpers.FindAndDeleteTestVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := testhelpers.FindAndDeleteTestVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates a VM, configures metadata tags on it, and reads them back", func() {
+			virtualGuestService, err := testhelpers.CreateVirtualGuestService()
+			Expect(err).ToNot(HaveOccurred())
+
+			virtualGuest, _, err := testhelpers.CreateAndWaitForRunningVirtualGuest(virtualGuestService, POLLING_INTERVAL, TIMEOUT, func() (datatypes.SoftLayer_Virtual_Guest, error) {
+				return virtualGuestService.CreateObject(testhelpers.TestVirtualGuestTemplate())
+			})
+			Expect(err).ToNot(HaveOccurred(), "failed creating and waiting for virtual guest to be RUNNING")
+
+			metadata := map[string]string{
+				"deployment": "softlayer-go",
+				"job":        "test-job",
+				"index":      "0",
+			}
+
+			configured, err := services.ConfigureMetadataOnVirtualGuest(virtualGuestService, virtualGuest.Id, metadata)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configured).To(BeTrue())
+
+			tagReferences, err := virtualGuestService.GetTagReferences(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+
+			tagNames := []string{}
+			for _, tagReference := range tagReferences {
+				tagNames = append(tagNames, tagReference.Tag.Name)
+			}
+			Expect(tagNames).To(ContainElement("deployment:softlayer-go"))
+			Expect(tagNames).To(ContainElement("job:test-job"))
+			Expect(tagNames).To(ContainElement("index:0"))
+
+			deleted, err := virtualGuestService.DeleteObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred(), "Error deleting virtual guest")
+			Expect(deleted).To(BeTrue())
+		})
+	})
+
+	Context("uses VirtualGuestCreator to create a virtual guest referencing an ssh key by public-key string", func() {
+		var (
+			TIMEOUT          time.Duration
+			POLLING_INTERVAL time.Duration
+		)
+
+		BeforeEach(func() {
+			TIMEOUT = 5 * time.Minute
+			POLLING_INTERVAL = 10 * time.Second
+
+			err := testhelpers.FindAndDeleteTestSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = testhelpers.FindAndDeleteTestVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := testhelpers.FindAndDeleteTestSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = testhelpers.FindAndDeleteTestVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates the ssh key implicitly and attaches it to the new virtual guest", func() {
+			sshKeyPath := os.Getenv("SOFTLAYER_GO_TEST_SSH_KEY_PATH")
+			Expect(sshKeyPath).ToNot(Equal(""), "SOFTLAYER_GO_TEST_SSH_KEY_PATH env variable is not set")
+
+			testSshKeyValue, err := ioutil.ReadFile(sshKeyPath)
+			Expect(err).ToNot(HaveOccurred())
+			publicKey := strings.Trim(string(testSshKeyValue), "\n")
+
+			creator, err := testhelpers.CreateVirtualGuestCreator()
+			Expect(err).ToNot(HaveOccurred())
+
+			virtualGuestService, err := testhelpers.CreateVirtualGuestService()
+			Expect(err).ToNot(HaveOccurred())
+
+			virtualGuest, _, err := testhelpers.CreateAndWaitForRunningVirtualGuest(virtualGuestService, POLLING_INTERVAL, TIMEOUT, func() (datatypes.SoftLayer_Virtual_Guest, error) {
+				return creator.CreateObject(testhelpers.TestVirtualGuestTemplate(), []services.SshKeySpec{
+					{PublicKey: publicKey},
+				})
+			})
+			Expect(err).ToNot(HaveOccurred(), "failed creating and waiting for virtual guest to be RUNNING")
+
+			deleted, err := virtualGuestService.DeleteObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred(), "Error deleting virtual guest")
+			Expect(deleted).To(BeTrue())
+		})
+
+		It("reuses the existing ssh key by fingerprint instead of creating a duplicate", func() {
+			sshKeyPath := os.Getenv("SOFTLAYER_GO_TEST_SSH_KEY_PATH")
+			Expect(sshKeyPath).ToNot(Equal(""), "SOFTLAYER_GO_TEST_SSH_KEY_PATH env variable is not set")
+
+			testSshKeyValue, err := ioutil.ReadFile(sshKeyPath)
+			Expect(err).ToNot(HaveOccurred())
+			publicKey := strings.Trim(string(testSshKeyValue), "\n")
+
+			creator, err := testhelpers.CreateVirtualGuestCreator()
+			Expect(err).ToNot(HaveOccurred())
+
+			accountService, err := testhelpers.CreateAccountService()
+			Expect(err).ToNot(HaveOccurred())
+
+			virtualGuestService, err := testhelpers.CreateVirtualGuestService()
+			Expect(err).ToNot(HaveOccurred())
+
+			createObject := func() (datatypes.SoftLayer_Virtual_Guest, error) {
+				return creator.CreateObject(testhelpers.TestVirtualGuestTemplate(), []services.SshKeySpec{
+					{PublicKey: publicKey},
+				})
+			}
+
+			fmt.Printf("----> creating first virtual guest, expecting the ssh key to be created\n")
+			firstGuest, _, err := testhelpers.CreateAndWaitForRunningVirtualGuest(virtualGuestService, POLLING_INTERVAL, TIMEOUT, createObject)
+			Expect(err).ToNot(HaveOccurred(), "failed creating and waiting for first virtual guest to be RUNNING")
+
+			keysAfterFirstCreate, err := accountService.GetSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keysAfterFirstCreate).To(HaveLen(1), "expected exactly one ssh key to have been created implicitly")
+
+			deleted, err := virtualGuestService.DeleteObject(firstGuest.Id)
+			Expect(err).ToNot(HaveOccurred(), "Error deleting first virtual guest")
+			Expect(deleted).To(BeTrue())
+
+			fmt.Printf("----> creating second virtual guest with the same public key, expecting the existing ssh key to be reused\n")
+			secondGuest, _, err := testhelpers.CreateAndWaitForRunningVirtualGuest(virtualGuestService, POLLING_INTERVAL, TIMEOUT, createObject)
+			Expect(err).ToNot(HaveOccurred(), "failed creating and waiting for second virtual guest to be RUNNING")
+
+			keysAfterSecondCreate, err := accountService.GetSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keysAfterSecondCreate).To(Equal(keysAfterFirstCreate), "CreateObject should have reused the existing ssh key instead of creating a duplicate")
+
+			deleted, err = virtualGuestService.DeleteObject(secondGuest.Id)
+			Expect(err).ToNot(HaveOccurred(), "Error deleting second virtual guest")
+			Expect(deleted).To(BeTrue())
+		})
+	})
+
+	Context("uses VirtualGuestSSHExecutor to run a command on a freshly created virtual guest", func() {
+		var (
+			TIMEOUT          time.Duration
+			POLLING_INTERVAL time.Duration
+		)
+
+		BeforeEach(func() {
+			if os.Getenv("SOFTLAYER_GO_TEST_SSH_EXECUTOR") == "" {
+				Skip("SOFTLAYER_GO_TEST_SSH_EXECUTOR is not set")
+			}
+
+			TIMEOUT = 5 * time.Minute
+			POLLING_INTERVAL = 10 * time.Second
+
+			err := testhelpers.FindAndDeleteTestVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := testhelpers.FindAndDeleteTestVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("runs 'uname -a' over ssh and gets non-empty stdout back", func() {
+			privateKeyPath := os.Getenv("SOFTLAYER_GO_TEST_SSH_PRIVATE_KEY_PATH")
+			Expect(privateKeyPath).ToNot(Equal(""), "SOFTLAYER_GO_TEST_SSH_PRIVATE_KEY_PATH env variable is not set")
+
+			privateKey, err := ioutil.ReadFile(privateKeyPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			sshKeyPath := os.Getenv("SOFTLAYER_GO_TEST_SSH_KEY_PATH")
+			Expect(sshKeyPath).ToNot(Equal(""), "SOFTLAYER_GO_TEST_SSH_KEY_PATH env variable is not set")
+
+			testSshKeyValue, err := ioutil.ReadFile(sshKeyPath)
+			Expect(err).ToNot(HaveOccurred())
+			publicKey := strings.Trim(string(testSshKeyValue), "\n")
+
+			creator, err := testhelpers.CreateVirtualGuestCreator()
+			Expect(err).ToNot(HaveOccurred())
+
+			virtualGuestService, err := testhelpers.CreateVirtualGuestService()
+			Expect(err).ToNot(HaveOccurred())
+
+			virtualGuest, waiter, err := testhelpers.CreateAndWaitForRunningVirtualGuest(virtualGuestService, POLLING_INTERVAL, TIMEOUT, func() (datatypes.SoftLayer_Virtual_Guest, error) {
+				return creator.CreateObject(testhelpers.TestVirtualGuestTemplate(), []services.SshKeySpec{
+					{PublicKey: publicKey},
+				})
+			})
+			Expect(err).ToNot(HaveOccurred(), "failed creating and waiting for virtual guest to be RUNNING")
+
+			err = waiter.WaitForVirtualGuestIsPingable(virtualGuest.Id, TIMEOUT)
+			Expect(err).ToNot(HaveOccurred(), "failed waiting for virtual guest to become pingable")
+
+			executor, err := softlayer.NewVirtualGuestSSHExecutor(virtualGuestService, virtualGuest.Id, softlayer.VirtualGuestSSHExecutorConfig{
+				PrivateKey: privateKey,
+				// Test instances are disposable and their host keys are
+				// never captured out of band, so there is nothing to pin
+				// against; this is the one context where that tradeoff
+				// is acceptable.
+				HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			stdout, _, exitCode, err := executor.RunCommand(context.Background(), "uname -a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(0))
+			Expect(stdout).ToNot(Equal(""))
+
+			deleted, err := virtualGuestService.DeleteObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred(), "Error deleting virtual guest")
+			Expect(deleted).To(BeTrue())
+		})
+	})
+
 	XContext("uses SoftLayer_Account to create a new instance and network storage and attach them", func() {
 		It("creates the virtual guest instance and waits for it to be active", func() {
 			Expect(false).To(BeTrue())
@@ -0,0 +1,16 @@
+package services
+
+import (
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// softLayer_Service is embedded by every concrete SoftLayer_*_Service
+// implementation. It holds the client used to reach the SoftLayer REST
+// API and supplies the shared parts of the softlayer.Service interface.
+type softLayer_Service struct {
+	client softlayer.Client
+}
+
+func (slr *softLayer_Service) GetClient() softlayer.Client {
+	return slr.client
+}
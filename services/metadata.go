@@ -0,0 +1,39 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// metadataTagPattern matches the characters SoftLayer allows in a tag:
+// letters, digits, spaces, '-', '_', '.', and ':' (the separator
+// ConfigureMetadataOnVirtualGuest uses between a key and its value).
+var metadataTagPattern = regexp.MustCompile(`^[A-Za-z0-9 ._:-]+$`)
+
+// ConfigureMetadataOnVirtualGuest flattens a BOSH-style metadata map
+// (deployment, job, index, compiling, ...) into "key:value" tags, sorted
+// by key, and applies them to the given instance through
+// SoftLayer_Virtual_Guest_Service.SetTags, which joins them into the
+// comma-separated string SoftLayer expects.
+func ConfigureMetadataOnVirtualGuest(virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service, instanceId int, metadata map[string]string) (bool, error) {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	tags := make([]string, 0, len(keys))
+	for _, key := range keys {
+		tag := fmt.Sprintf("%s:%s", key, metadata[key])
+		if !metadataTagPattern.MatchString(tag) {
+			return false, fmt.Errorf("metadata tag %q contains characters SoftLayer does not allow in a tag", tag)
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return virtualGuestService.SetTags(instanceId, tags)
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Account_Service struct {
+	softLayer_Service
+}
+
+func NewSoftLayer_Account_Service(client softlayer.Client) *softLayer_Account_Service {
+	return &softLayer_Account_Service{
+		softLayer_Service{client: client},
+	}
+}
+
+func (slas *softLayer_Account_Service) GetName() string {
+	return "SoftLayer_Account"
+}
+
+func (slas *softLayer_Account_Service) GetVirtualDiskImages() ([]datatypes.SoftLayer_Virtual_Disk_Image, error) {
+	response, err := slas.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/getVirtualDiskImages.json", slas.GetName()),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return []datatypes.SoftLayer_Virtual_Disk_Image{}, err
+	}
+
+	virtualDiskImages := []datatypes.SoftLayer_Virtual_Disk_Image{}
+	if err := json.Unmarshal(response, &virtualDiskImages); err != nil {
+		return []datatypes.SoftLayer_Virtual_Disk_Image{}, err
+	}
+
+	return virtualDiskImages, nil
+}
+
+func (slas *softLayer_Account_Service) GetVirtualGuests() ([]datatypes.SoftLayer_Virtual_Guest, error) {
+	response, err := slas.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/getVirtualGuests.json", slas.GetName()),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return []datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
+	if err := json.Unmarshal(response, &virtualGuests); err != nil {
+		return []datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	return virtualGuests, nil
+}
+
+func (slas *softLayer_Account_Service) GetNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error) {
+	response, err := slas.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/getNetworkStorage.json", slas.GetName()),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	networkStorage := []datatypes.SoftLayer_Network_Storage{}
+	if err := json.Unmarshal(response, &networkStorage); err != nil {
+		return []datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	return networkStorage, nil
+}
+
+func (slas *softLayer_Account_Service) GetSshKeys() ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
+	response, err := slas.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/getSshKeys.json", slas.GetName()),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
+	}
+
+	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
+	if err := json.Unmarshal(response, &sshKeys); err != nil {
+		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
+	}
+
+	return sshKeys, nil
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Product_Order_Service struct {
+	softLayer_Service
+}
+
+func NewSoftLayer_Product_Order_Service(client softlayer.Client) *softLayer_Product_Order_Service {
+	return &softLayer_Product_Order_Service{
+		softLayer_Service{client: client},
+	}
+}
+
+func (slpos *softLayer_Product_Order_Service) GetName() string {
+	return "SoftLayer_Product_Order"
+}
+
+func (slpos *softLayer_Product_Order_Service) VerifyOrder(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	return slpos.placeOrRunVerify("verifyOrder", order)
+}
+
+func (slpos *softLayer_Product_Order_Service) PlaceOrder(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	return slpos.placeOrRunVerify("placeOrder", order)
+}
+
+func (slpos *softLayer_Product_Order_Service) placeOrRunVerify(method string, order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	order.ComplexType = "SoftLayer_Container_Product_Order_Virtual_Guest"
+
+	requestBody, err := json.Marshal(struct {
+		Parameters []datatypes.SoftLayer_Container_Product_Order_Virtual_Guest `json:"parameters"`
+	}{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Virtual_Guest{order},
+	})
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	response, err := slpos.client.GetHttpClient().DoRawHttpRequest(
+		slpos.GetName()+"/"+method+".json",
+		"POST",
+		bytes.NewBuffer(requestBody),
+	)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
+	if err := json.Unmarshal(response, &receipt); err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return receipt, nil
+}
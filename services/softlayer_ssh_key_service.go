@@ -0,0 +1,123 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Ssh_Key_Service struct {
+	softLayer_Service
+}
+
+func NewSoftLayer_Ssh_Key_Service(client softlayer.Client) *softLayer_Ssh_Key_Service {
+	return &softLayer_Ssh_Key_Service{
+		softLayer_Service{client: client},
+	}
+}
+
+func (slsks *softLayer_Ssh_Key_Service) GetName() string {
+	return "SoftLayer_Security_Ssh_Key"
+}
+
+func (slsks *softLayer_Ssh_Key_Service) CreateObject(template datatypes.SoftLayer_Ssh_Key) (datatypes.SoftLayer_Ssh_Key, error) {
+	requestBody, err := json.Marshal(struct {
+		Parameters []datatypes.SoftLayer_Ssh_Key `json:"parameters"`
+	}{
+		Parameters: []datatypes.SoftLayer_Ssh_Key{template},
+	})
+	if err != nil {
+		return datatypes.SoftLayer_Ssh_Key{}, err
+	}
+
+	response, err := slsks.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/createObject.json", slsks.GetName()),
+		"POST",
+		bytes.NewBuffer(requestBody),
+	)
+	if err != nil {
+		return datatypes.SoftLayer_Ssh_Key{}, err
+	}
+
+	sshKey := datatypes.SoftLayer_Ssh_Key{}
+	if err := json.Unmarshal(response, &sshKey); err != nil {
+		return datatypes.SoftLayer_Ssh_Key{}, err
+	}
+
+	return sshKey, nil
+}
+
+func (slsks *softLayer_Ssh_Key_Service) GetObject(sshKeyId int) (datatypes.SoftLayer_Ssh_Key, error) {
+	response, err := slsks.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getObject.json", slsks.GetName(), sshKeyId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return datatypes.SoftLayer_Ssh_Key{}, err
+	}
+
+	sshKey := datatypes.SoftLayer_Ssh_Key{}
+	if err := json.Unmarshal(response, &sshKey); err != nil {
+		return datatypes.SoftLayer_Ssh_Key{}, err
+	}
+
+	return sshKey, nil
+}
+
+func (slsks *softLayer_Ssh_Key_Service) EditObject(sshKeyId int, template datatypes.SoftLayer_Ssh_Key) (bool, error) {
+	requestBody, err := json.Marshal(struct {
+		Parameters []datatypes.SoftLayer_Ssh_Key `json:"parameters"`
+	}{
+		Parameters: []datatypes.SoftLayer_Ssh_Key{template},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	response, err := slsks.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/editObject.json", slsks.GetName(), sshKeyId),
+		"POST",
+		bytes.NewBuffer(requestBody),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(string(response), "true"), nil
+}
+
+func (slsks *softLayer_Ssh_Key_Service) DeleteObject(sshKeyId int) (bool, error) {
+	response, err := slsks.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/deleteObject.json", slsks.GetName(), sshKeyId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(string(response), "true"), nil
+}
+
+func (slsks *softLayer_Ssh_Key_Service) GetSoftwarePasswords(sshKeyId int) ([]datatypes.SoftLayer_Software_Password, error) {
+	response, err := slsks.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getSoftwarePasswords.json", slsks.GetName(), sshKeyId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return []datatypes.SoftLayer_Software_Password{}, err
+	}
+
+	softwarePasswords := []datatypes.SoftLayer_Software_Password{}
+	if err := json.Unmarshal(response, &softwarePasswords); err != nil {
+		return []datatypes.SoftLayer_Software_Password{}, err
+	}
+
+	return softwarePasswords, nil
+}
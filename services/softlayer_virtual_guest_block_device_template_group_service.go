@@ -0,0 +1,42 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Virtual_Guest_Block_Device_Template_Group_Service struct {
+	softLayer_Service
+}
+
+func NewSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service(client softlayer.Client) *softLayer_Virtual_Guest_Block_Device_Template_Group_Service {
+	return &softLayer_Virtual_Guest_Block_Device_Template_Group_Service{
+		softLayer_Service{client: client},
+	}
+}
+
+func (slbdtgs *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetName() string {
+	return "SoftLayer_Virtual_Guest_Block_Device_Template_Group"
+}
+
+func (slbdtgs *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetActiveTransactions(globalIdentifier string) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, err := slbdtgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%s/getActiveTransactions.json", slbdtgs.GetName(), globalIdentifier),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	transactions := []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	if err := json.Unmarshal(response, &transactions); err != nil {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	return transactions, nil
+}
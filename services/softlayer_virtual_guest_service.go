@@ -0,0 +1,229 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Virtual_Guest_Service struct {
+	softLayer_Service
+}
+
+func NewSoftLayer_Virtual_Guest_Service(client softlayer.Client) *softLayer_Virtual_Guest_Service {
+	return &softLayer_Virtual_Guest_Service{
+		softLayer_Service{client: client},
+	}
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetName() string {
+	return "SoftLayer_Virtual_Guest"
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CreateObject(template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error) {
+	requestBody, err := json.Marshal(struct {
+		Parameters []datatypes.SoftLayer_Virtual_Guest_Template `json:"parameters"`
+	}{
+		Parameters: []datatypes.SoftLayer_Virtual_Guest_Template{template},
+	})
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/createObject.json", slvgs.GetName()),
+		"POST",
+		bytes.NewBuffer(requestBody),
+	)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+	if err := json.Unmarshal(response, &virtualGuest); err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	return virtualGuest, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetObject(instanceId int) (datatypes.SoftLayer_Virtual_Guest, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getObject.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+	if err := json.Unmarshal(response, &virtualGuest); err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	return virtualGuest, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) DeleteObject(instanceId int) (bool, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/deleteObject.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(string(response), "true"), nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPowerState(instanceId int) (datatypes.SoftLayer_Container_Virtual_Guest_PowerState, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getPowerState.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Virtual_Guest_PowerState{}, err
+	}
+
+	powerState := datatypes.SoftLayer_Container_Virtual_Guest_PowerState{}
+	if err := json.Unmarshal(response, &powerState); err != nil {
+		return datatypes.SoftLayer_Container_Virtual_Guest_PowerState{}, err
+	}
+
+	return powerState, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactions(instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getActiveTransactions.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	transactions := []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	if err := json.Unmarshal(response, &transactions); err != nil {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	return transactions, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) IsPingable(instanceId int) (bool, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/isPingable.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(string(response), "true"), nil
+}
+
+// SetTags replaces the resource's tag set. SoftLayer expects tags as a
+// single comma-separated string, so callers passing e.g.
+// []string{"deployment:x", "job:y"} get them joined here.
+func (slvgs *softLayer_Virtual_Guest_Service) SetTags(instanceId int, tags []string) (bool, error) {
+	requestBody, err := json.Marshal(struct {
+		Parameters []string `json:"parameters"`
+	}{
+		Parameters: []string{strings.Join(tags, ",")},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/setTags.json", slvgs.GetName(), instanceId),
+		"POST",
+		bytes.NewBuffer(requestBody),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.EqualFold(string(response), "true"), nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetTagReferences(instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getTagReferences.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return []datatypes.SoftLayer_Tag_Reference{}, err
+	}
+
+	tagReferences := []datatypes.SoftLayer_Tag_Reference{}
+	if err := json.Unmarshal(response, &tagReferences); err != nil {
+		return []datatypes.SoftLayer_Tag_Reference{}, err
+	}
+
+	return tagReferences, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryIpAddress(instanceId int) (string, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getPrimaryIpAddress.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	var primaryIpAddress string
+	if err := json.Unmarshal(response, &primaryIpAddress); err != nil {
+		return "", err
+	}
+
+	return primaryIpAddress, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryBackendIpAddress(instanceId int) (string, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getPrimaryBackendIpAddress.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	var primaryBackendIpAddress string
+	if err := json.Unmarshal(response, &primaryBackendIpAddress); err != nil {
+		return "", err
+	}
+
+	return primaryBackendIpAddress, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetOperatingSystem(instanceId int) (datatypes.SoftLayer_Operating_System, error) {
+	response, err := slvgs.client.GetHttpClient().DoRawHttpRequest(
+		fmt.Sprintf("%s/%d/getOperatingSystem.json", slvgs.GetName(), instanceId),
+		"GET",
+		&bytes.Buffer{},
+	)
+	if err != nil {
+		return datatypes.SoftLayer_Operating_System{}, err
+	}
+
+	operatingSystem := datatypes.SoftLayer_Operating_System{}
+	if err := json.Unmarshal(response, &operatingSystem); err != nil {
+		return datatypes.SoftLayer_Operating_System{}, err
+	}
+
+	return operatingSystem, nil
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// sshPublicKeyFingerprint reproduces the colon-separated hex MD5
+// fingerprint SoftLayer stores on SoftLayer_Security_Ssh_Key.Fingerprint,
+// given an authorized_keys-style public key line
+// ("ssh-rsa AAAAB3Nz... comment"). It exists so VirtualGuestCreator can
+// match a caller-supplied public key against the account's existing keys
+// without pulling in crypto/ssh.
+func sshPublicKeyFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(strings.TrimSpace(publicKey))
+	if len(fields) < 2 {
+		return "", fmt.Errorf("ssh public key is not in 'type base64-blob [comment]' format")
+	}
+
+	switch fields[0] {
+	case "ssh-rsa", "ssh-ed25519", "ssh-dss", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521":
+	default:
+		return "", fmt.Errorf("unsupported ssh public key type %q", fields[0])
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("failed to base64-decode ssh public key blob: %s", err.Error())
+	}
+
+	sum := md5.Sum(blob)
+
+	hexBytes := make([]string, len(sum))
+	for i, b := range sum {
+		hexBytes[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.Join(hexBytes, ":"), nil
+}
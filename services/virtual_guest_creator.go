@@ -0,0 +1,120 @@
+package services
+
+import (
+	"fmt"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// SshKeySpec identifies an SSH key to attach to a virtual guest
+// template by whichever of Id, Label or PublicKey the caller has on
+// hand. Exactly one field should be set; VirtualGuestCreator resolves
+// it to a SoftLayer key id in that order.
+type SshKeySpec struct {
+	Id        int
+	Label     string
+	PublicKey string
+}
+
+// VirtualGuestCreator resolves a list of SshKeySpecs against the
+// account's existing SSH keys (creating a key for any PublicKey spec
+// whose fingerprint matches none of them; a Label spec that matches no
+// key is an error) and then creates the virtual guest, so callers
+// building a stemcell-based
+// template don't need to manage SoftLayer key ids themselves.
+type VirtualGuestCreator struct {
+	accountService      softlayer.SoftLayer_Account_Service
+	sshKeyService       softlayer.SoftLayer_Ssh_Key_Service
+	virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service
+}
+
+func NewVirtualGuestCreator(
+	accountService softlayer.SoftLayer_Account_Service,
+	sshKeyService softlayer.SoftLayer_Ssh_Key_Service,
+	virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service,
+) *VirtualGuestCreator {
+	return &VirtualGuestCreator{
+		accountService:      accountService,
+		sshKeyService:       sshKeyService,
+		virtualGuestService: virtualGuestService,
+	}
+}
+
+func (c *VirtualGuestCreator) CreateObject(template datatypes.SoftLayer_Virtual_Guest_Template, sshKeys []SshKeySpec) (datatypes.SoftLayer_Virtual_Guest, error) {
+	resolvedIds, err := c.resolveSshKeyIds(sshKeys)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, err
+	}
+
+	template.SshKeys = make([]datatypes.SshKeyRef, len(resolvedIds))
+	for i, id := range resolvedIds {
+		template.SshKeys[i] = datatypes.SshKeyRef{Id: id}
+	}
+
+	return c.virtualGuestService.CreateObject(template)
+}
+
+func (c *VirtualGuestCreator) resolveSshKeyIds(sshKeys []SshKeySpec) ([]int, error) {
+	if len(sshKeys) == 0 {
+		return []int{}, nil
+	}
+
+	existingKeys, err := c.accountService.GetSshKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedIds := make([]int, 0, len(sshKeys))
+
+	for _, spec := range sshKeys {
+		id, err := c.resolveSshKeyId(spec, existingKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		resolvedIds = append(resolvedIds, id)
+	}
+
+	return resolvedIds, nil
+}
+
+func (c *VirtualGuestCreator) resolveSshKeyId(spec SshKeySpec, existingKeys []datatypes.SoftLayer_Ssh_Key) (int, error) {
+	if spec.Id != 0 {
+		return spec.Id, nil
+	}
+
+	if spec.Label != "" {
+		for _, existingKey := range existingKeys {
+			if existingKey.Label == spec.Label {
+				return existingKey.Id, nil
+			}
+		}
+
+		return 0, fmt.Errorf("no ssh key found on the account with label %q", spec.Label)
+	}
+
+	if spec.PublicKey != "" {
+		fingerprint, err := sshPublicKeyFingerprint(spec.PublicKey)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, existingKey := range existingKeys {
+			if existingKey.Fingerprint == fingerprint {
+				return existingKey.Id, nil
+			}
+		}
+
+		createdKey, err := c.sshKeyService.CreateObject(datatypes.SoftLayer_Ssh_Key{
+			Key: spec.PublicKey,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return createdKey.Id, nil
+	}
+
+	return 0, fmt.Errorf("ssh key spec must set one of Id, Label or PublicKey")
+}
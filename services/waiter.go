@@ -0,0 +1,107 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// VirtualGuestTimeoutError is returned by the Wait* helpers below when
+// the condition they are polling for does not become true before the
+// caller's timeout elapses. Resource is whatever identifies the thing
+// being waited on (an instance id, a block device template group's
+// global identifier, ...).
+type VirtualGuestTimeoutError struct {
+	Resource  interface{}
+	Timeout   time.Duration
+	Condition string
+}
+
+func (e VirtualGuestTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for %v to %s", e.Timeout, e.Resource, e.Condition)
+}
+
+// VirtualGuestWaiter polls SoftLayer_Virtual_Guest_Service (and, for the
+// stemcell case, SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service)
+// for the conditions every downstream consumer of a freshly created or
+// reloaded virtual guest needs to wait on. It replaces the
+// Eventually(...).Should(...) polling loops that used to live inline in
+// the integration tests.
+type VirtualGuestWaiter struct {
+	virtualGuestService             softlayer.SoftLayer_Virtual_Guest_Service
+	blockDeviceTemplateGroupService softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+	pollingInterval                 time.Duration
+}
+
+func NewVirtualGuestWaiter(
+	virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service,
+	blockDeviceTemplateGroupService softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service,
+	pollingInterval time.Duration,
+) *VirtualGuestWaiter {
+	return &VirtualGuestWaiter{
+		virtualGuestService:             virtualGuestService,
+		blockDeviceTemplateGroupService: blockDeviceTemplateGroupService,
+		pollingInterval:                 pollingInterval,
+	}
+}
+
+func (w *VirtualGuestWaiter) WaitForVirtualGuestToHavePowerState(instanceId int, state string, timeout time.Duration) error {
+	return w.poll(instanceId, "reach power state "+state, timeout, func() (bool, error) {
+		powerState, err := w.virtualGuestService.GetPowerState(instanceId)
+		if err != nil {
+			return false, err
+		}
+
+		return powerState.KeyName == state, nil
+	})
+}
+
+func (w *VirtualGuestWaiter) WaitForVirtualGuestIsPingable(instanceId int, timeout time.Duration) error {
+	return w.poll(instanceId, "become pingable", timeout, func() (bool, error) {
+		return w.virtualGuestService.IsPingable(instanceId)
+	})
+}
+
+func (w *VirtualGuestWaiter) WaitForVirtualGuestHasNoActiveTransactions(instanceId int, timeout time.Duration) error {
+	return w.poll(instanceId, "have no active transactions", timeout, func() (bool, error) {
+		transactions, err := w.virtualGuestService.GetActiveTransactions(instanceId)
+		if err != nil {
+			return false, err
+		}
+
+		return len(transactions) == 0, nil
+	})
+}
+
+func (w *VirtualGuestWaiter) WaitForVirtualGuestBlockTemplateGroupToHaveNoActiveTransactions(globalIdentifier string, timeout time.Duration) error {
+	return w.poll(globalIdentifier, "have no active transactions", timeout, func() (bool, error) {
+		transactions, err := w.blockDeviceTemplateGroupService.GetActiveTransactions(globalIdentifier)
+		if err != nil {
+			return false, err
+		}
+
+		return len(transactions) == 0, nil
+	})
+}
+
+func (w *VirtualGuestWaiter) poll(resource interface{}, condition string, timeout time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		ok, err := check()
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return VirtualGuestTimeoutError{Resource: resource, Timeout: timeout, Condition: condition}
+		}
+
+		time.Sleep(w.pollingInterval)
+	}
+}
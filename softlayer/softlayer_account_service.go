@@ -0,0 +1,14 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Account_Service interface {
+	Service
+
+	GetVirtualDiskImages() ([]datatypes.SoftLayer_Virtual_Disk_Image, error)
+	GetVirtualGuests() ([]datatypes.SoftLayer_Virtual_Guest, error)
+	GetNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error)
+	GetSshKeys() ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+}
@@ -0,0 +1,16 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+// SoftLayer_Product_Order_Service places and verifies product orders,
+// the path SoftLayer requires for anything that can't be expressed on a
+// SoftLayer_Virtual_Guest_Service.CreateObject template: monthly
+// billing, upgrades, and add-ons.
+type SoftLayer_Product_Order_Service interface {
+	Service
+
+	VerifyOrder(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceOrder(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+}
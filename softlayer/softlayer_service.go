@@ -0,0 +1,27 @@
+package softlayer
+
+import (
+	"io"
+)
+
+// Service is embedded by every SoftLayer_*_Service interface. GetName
+// identifies the SoftLayer REST resource the service talks to (e.g.
+// "SoftLayer_Virtual_Guest"), which the concrete implementations in
+// package services use to build request paths.
+type Service interface {
+	GetName() string
+}
+
+// Client is the dependency every concrete service in package services
+// takes: just enough to reach the SoftLayer REST transport. The
+// concrete implementation, SoftLayer_Client, lives in package client.
+type Client interface {
+	GetHttpClient() HttpClient
+}
+
+// HttpClient is the minimal REST transport a service needs to call a
+// single SoftLayer method: a raw request against the SoftLayer REST
+// endpoint, returning the raw JSON response body.
+type HttpClient interface {
+	DoRawHttpRequest(path string, requestType string, requestBody io.Reader) ([]byte, error)
+}
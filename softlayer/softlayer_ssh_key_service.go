@@ -8,5 +8,8 @@ type SoftLayer_Ssh_Key_Service interface {
 	Service
 
 	CreateObject(template datatypes.SoftLayer_Ssh_Key) (datatypes.SoftLayer_Ssh_Key, error)
+	GetObject(sshKeyId int) (datatypes.SoftLayer_Ssh_Key, error)
+	EditObject(sshKeyId int, template datatypes.SoftLayer_Ssh_Key) (bool, error)
 	DeleteObject(sshKeyId int) (bool, error)
+	GetSoftwarePasswords(sshKeyId int) ([]datatypes.SoftLayer_Software_Password, error)
 }
@@ -0,0 +1,15 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+// SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service covers the
+// stemcell/image side of virtual guest provisioning: looking up a
+// template group's active transactions while SoftLayer finishes
+// transcoding or replicating it across datacenters.
+type SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service interface {
+	Service
+
+	GetActiveTransactions(globalIdentifier string) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+}
@@ -0,0 +1,24 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Virtual_Guest_Service interface {
+	Service
+
+	CreateObject(template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error)
+	GetObject(instanceId int) (datatypes.SoftLayer_Virtual_Guest, error)
+	DeleteObject(instanceId int) (bool, error)
+
+	GetPowerState(instanceId int) (datatypes.SoftLayer_Container_Virtual_Guest_PowerState, error)
+	GetActiveTransactions(instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	IsPingable(instanceId int) (bool, error)
+
+	SetTags(instanceId int, tags []string) (bool, error)
+	GetTagReferences(instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error)
+
+	GetPrimaryIpAddress(instanceId int) (string, error)
+	GetPrimaryBackendIpAddress(instanceId int) (string, error)
+	GetOperatingSystem(instanceId int) (datatypes.SoftLayer_Operating_System, error)
+}
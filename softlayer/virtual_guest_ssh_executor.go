@@ -0,0 +1,208 @@
+package softlayer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// VirtualGuestSSHExecutorConfig controls how a VirtualGuestSSHExecutor
+// connects. Leave PrivateKey empty to authenticate through the agent at
+// SSH_AUTH_SOCK instead.
+//
+// HostKeyCallback is required and has no default: a freshly provisioned
+// virtual guest's host key cannot be verified against any prior
+// knowledge, so callers must decide for themselves how to handle that,
+// e.g. ssh.FixedHostKey if the key was captured out of band, or the
+// insecure ssh.InsecureIgnoreHostKey() for disposable test instances
+// where that tradeoff is acceptable. NewVirtualGuestSSHExecutor returns
+// an error if it is left nil.
+type VirtualGuestSSHExecutorConfig struct {
+	User            string
+	PrivateKey      []byte
+	HostKeyCallback ssh.HostKeyCallback
+	Port            int
+	RetryInterval   time.Duration
+	RetryTimeout    time.Duration
+}
+
+func (c VirtualGuestSSHExecutorConfig) withDefaults() VirtualGuestSSHExecutorConfig {
+	if c.User == "" {
+		c.User = "root"
+	}
+	if c.Port == 0 {
+		c.Port = 22
+	}
+	if c.RetryInterval == 0 {
+		c.RetryInterval = 5 * time.Second
+	}
+	if c.RetryTimeout == 0 {
+		c.RetryTimeout = 5 * time.Minute
+	}
+
+	return c
+}
+
+// VirtualGuestSSHExecutor runs commands and uploads files on a virtual
+// guest over SSH once it has finished provisioning, so callers don't
+// have to bring their own SSH client to bootstrap the instance.
+type VirtualGuestSSHExecutor struct {
+	virtualGuestService SoftLayer_Virtual_Guest_Service
+	instanceId          int
+	config              VirtualGuestSSHExecutorConfig
+}
+
+func NewVirtualGuestSSHExecutor(virtualGuestService SoftLayer_Virtual_Guest_Service, instanceId int, config VirtualGuestSSHExecutorConfig) (*VirtualGuestSSHExecutor, error) {
+	if config.HostKeyCallback == nil {
+		return nil, fmt.Errorf("VirtualGuestSSHExecutorConfig.HostKeyCallback is required; set it explicitly (e.g. to ssh.InsecureIgnoreHostKey() for disposable test instances) rather than relying on a default")
+	}
+
+	return &VirtualGuestSSHExecutor{
+		virtualGuestService: virtualGuestService,
+		instanceId:          instanceId,
+		config:              config.withDefaults(),
+	}, nil
+}
+
+func (e *VirtualGuestSSHExecutor) RunCommand(ctx context.Context, cmd string) (stdout string, stderr string, exitCode int, err error) {
+	client, err := e.dial(ctx)
+	if err != nil {
+		return "", "", -1, err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return "", "", -1, err
+	}
+	defer session.Close()
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+	session.Stdout = &stdoutBuf
+	session.Stderr = &stderrBuf
+
+	if err := session.Run(cmd); err != nil {
+		if exitErr, ok := err.(*ssh.ExitError); ok {
+			return stdoutBuf.String(), stderrBuf.String(), exitErr.ExitStatus(), nil
+		}
+
+		return stdoutBuf.String(), stderrBuf.String(), -1, err
+	}
+
+	return stdoutBuf.String(), stderrBuf.String(), 0, nil
+}
+
+func (e *VirtualGuestSSHExecutor) UploadFile(ctx context.Context, localPath string, remotePath string, mode os.FileMode) error {
+	contents, err := os.ReadFile(localPath)
+	if err != nil {
+		return err
+	}
+
+	client, err := e.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	session.Stdin = bytes.NewReader(contents)
+
+	cmd := fmt.Sprintf("cat > %s && chmod %o %s", remotePath, mode.Perm(), remotePath)
+	return session.Run(cmd)
+}
+
+func (e *VirtualGuestSSHExecutor) dial(ctx context.Context) (*ssh.Client, error) {
+	host, err := e.resolveHost()
+	if err != nil {
+		return nil, err
+	}
+
+	authMethod, err := e.authMethod()
+	if err != nil {
+		return nil, err
+	}
+
+	clientConfig := &ssh.ClientConfig{
+		User:            e.config.User,
+		Auth:            []ssh.AuthMethod{authMethod},
+		HostKeyCallback: e.config.HostKeyCallback,
+		Timeout:         30 * time.Second,
+	}
+
+	address := fmt.Sprintf("%s:%d", host, e.config.Port)
+	deadline := time.Now().Add(e.config.RetryTimeout)
+
+	for {
+		client, dialErr := ssh.Dial("tcp", address, clientConfig)
+		if dialErr == nil {
+			return client, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %s dialing %s: %s", e.config.RetryTimeout, address, dialErr.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(e.config.RetryInterval):
+		}
+	}
+}
+
+func (e *VirtualGuestSSHExecutor) resolveHost() (string, error) {
+	if primaryIpAddress, err := e.virtualGuestService.GetPrimaryIpAddress(e.instanceId); err == nil && primaryIpAddress != "" {
+		return primaryIpAddress, nil
+	}
+
+	primaryBackendIpAddress, err := e.virtualGuestService.GetPrimaryBackendIpAddress(e.instanceId)
+	if err != nil {
+		return "", err
+	}
+	if primaryBackendIpAddress == "" {
+		return "", fmt.Errorf("virtual guest %d has neither a primary nor a primary backend ip address", e.instanceId)
+	}
+
+	return primaryBackendIpAddress, nil
+}
+
+func (e *VirtualGuestSSHExecutor) authMethod() (ssh.AuthMethod, error) {
+	if len(e.config.PrivateKey) > 0 {
+		signer, err := ssh.ParsePrivateKey(e.config.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return ssh.PublicKeys(signer), nil
+	}
+
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		agentConn, err := net.Dial("unix", sock)
+		if err != nil {
+			return nil, err
+		}
+
+		return ssh.PublicKeysCallback(agent.NewClient(agentConn).Signers), nil
+	}
+
+	operatingSystem, err := e.virtualGuestService.GetOperatingSystem(e.instanceId)
+	if err != nil {
+		return nil, err
+	}
+	if len(operatingSystem.Passwords) == 0 {
+		return nil, fmt.Errorf("virtual guest %d has no operating system passwords to fall back on", e.instanceId)
+	}
+
+	return ssh.Password(operatingSystem.Passwords[0].Password), nil
+}
@@ -0,0 +1,233 @@
+package test_helpers
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	client "github.com/maximilien/softlayer-go/client"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	services "github.com/maximilien/softlayer-go/services"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// TEST_LABEL_PREFIX and TEST_NOTES_PREFIX mark the resources the
+// integration suite creates, so FindAndDeleteTest* can find and clean
+// them back up regardless of which test created them. SSH keys that
+// VirtualGuestCreator creates from a PublicKey spec carry no label, so
+// FindAndDeleteTestSshKeys does not remove them.
+const (
+	TEST_LABEL_PREFIX = "TEST:softlayer-go"
+	TEST_NOTES_PREFIX = "TEST:softlayer-go"
+)
+
+func createClient() (*client.SoftLayer_Client, error) {
+	username := os.Getenv("SOFTLAYER_GO_USER_NAME")
+	apiKey := os.Getenv("SOFTLAYER_GO_API_KEY")
+	if username == "" || apiKey == "" {
+		return nil, fmt.Errorf("SOFTLAYER_GO_USER_NAME and SOFTLAYER_GO_API_KEY env variables must be set")
+	}
+
+	return client.NewSoftLayer_Client(username, apiKey), nil
+}
+
+func CreateAccountService() (softlayer.SoftLayer_Account_Service, error) {
+	c, err := createClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetSoftLayer_Account_Service(), nil
+}
+
+func CreateVirtualGuestService() (softlayer.SoftLayer_Virtual_Guest_Service, error) {
+	c, err := createClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetSoftLayer_Virtual_Guest_Service(), nil
+}
+
+func CreateSshKeyService() (softlayer.SoftLayer_Ssh_Key_Service, error) {
+	c, err := createClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetSoftLayer_Ssh_Key_Service(), nil
+}
+
+// CreateSecuritySshKeyService is an alias for CreateSshKeyService:
+// SoftLayer_Security_Ssh_Key and SoftLayer_Ssh_Key_Service are the same
+// REST resource, kept under both names for callers that predate the
+// SoftLayer_Ssh_Key_Service rename.
+func CreateSecuritySshKeyService() (softlayer.SoftLayer_Ssh_Key_Service, error) {
+	return CreateSshKeyService()
+}
+
+func CreateProductOrderService() (softlayer.SoftLayer_Product_Order_Service, error) {
+	c, err := createClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetSoftLayer_Product_Order_Service(), nil
+}
+
+func CreateVirtualGuestCreator() (*services.VirtualGuestCreator, error) {
+	c, err := createClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return services.NewVirtualGuestCreator(
+		c.GetSoftLayer_Account_Service(),
+		c.GetSoftLayer_Ssh_Key_Service(),
+		c.GetSoftLayer_Virtual_Guest_Service(),
+	), nil
+}
+
+func CreateVirtualGuestWaiter(virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service, pollingInterval time.Duration) *services.VirtualGuestWaiter {
+	c, err := createClient()
+	if err != nil {
+		return services.NewVirtualGuestWaiter(virtualGuestService, nil, pollingInterval)
+	}
+
+	return services.NewVirtualGuestWaiter(virtualGuestService, c.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service(), pollingInterval)
+}
+
+// MarkVirtualGuestAsTest tags a freshly created virtual guest with
+// TEST_LABEL_PREFIX so FindAndDeleteTestVirtualGuests can recognize and
+// clean it up by tag even if it doesn't use the shared "test" hostname,
+// or if the test that created it fails before deleting it itself.
+func MarkVirtualGuestAsTest(virtualGuest datatypes.SoftLayer_Virtual_Guest) error {
+	virtualGuestService, err := CreateVirtualGuestService()
+	if err != nil {
+		return err
+	}
+
+	_, err = virtualGuestService.SetTags(virtualGuest.Id, []string{TEST_LABEL_PREFIX})
+	return err
+}
+
+// TestVirtualGuestTemplate returns the minimal hourly-billed,
+// local-disk virtual guest template the integration suite provisions
+// against whenever a test needs a real running instance.
+func TestVirtualGuestTemplate() datatypes.SoftLayer_Virtual_Guest_Template {
+	return datatypes.SoftLayer_Virtual_Guest_Template{
+		Hostname:  "test",
+		Domain:    "softlayergo.com",
+		StartCpus: 1,
+		MaxMemory: 1024,
+		Datacenter: datatypes.Datacenter{
+			Name: "ams01",
+		},
+		HourlyBillingFlag:            true,
+		LocalDiskFlag:                true,
+		OperatingSystemReferenceCode: "UBUNTU_LATEST",
+	}
+}
+
+// CreateAndWaitForRunningVirtualGuest runs the create -> wait-for-RUNNING
+// -> mark-as-test sequence shared by every integration test that needs a
+// live virtual guest. The caller supplies create so it can go through
+// either SoftLayer_Virtual_Guest_Service.CreateObject directly or a
+// higher-level path such as VirtualGuestCreator.CreateObject.
+func CreateAndWaitForRunningVirtualGuest(
+	virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service,
+	pollingInterval time.Duration,
+	timeout time.Duration,
+	create func() (datatypes.SoftLayer_Virtual_Guest, error),
+) (datatypes.SoftLayer_Virtual_Guest, *services.VirtualGuestWaiter, error) {
+	waiter := CreateVirtualGuestWaiter(virtualGuestService, pollingInterval)
+
+	virtualGuest, err := create()
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest{}, waiter, err
+	}
+
+	if err := waiter.WaitForVirtualGuestToHavePowerState(virtualGuest.Id, "RUNNING", timeout); err != nil {
+		return virtualGuest, waiter, err
+	}
+
+	if err := MarkVirtualGuestAsTest(virtualGuest); err != nil {
+		return virtualGuest, waiter, err
+	}
+
+	return virtualGuest, waiter, nil
+}
+
+func FindAndDeleteTestSshKeys() error {
+	accountService, err := CreateAccountService()
+	if err != nil {
+		return err
+	}
+
+	securitySshKeyService, err := CreateSecuritySshKeyService()
+	if err != nil {
+		return err
+	}
+
+	sshKeys, err := accountService.GetSshKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, sshKey := range sshKeys {
+		if sshKey.Label != TEST_LABEL_PREFIX {
+			continue
+		}
+
+		if _, err := securitySshKeyService.DeleteObject(sshKey.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func FindAndDeleteTestVirtualGuests() error {
+	accountService, err := CreateAccountService()
+	if err != nil {
+		return err
+	}
+
+	virtualGuestService, err := CreateVirtualGuestService()
+	if err != nil {
+		return err
+	}
+
+	virtualGuests, err := accountService.GetVirtualGuests()
+	if err != nil {
+		return err
+	}
+
+	for _, virtualGuest := range virtualGuests {
+		isTestGuest := virtualGuest.Hostname == "test"
+
+		if !isTestGuest {
+			tagReferences, err := virtualGuestService.GetTagReferences(virtualGuest.Id)
+			if err != nil {
+				return err
+			}
+
+			for _, tagReference := range tagReferences {
+				if tagReference.Tag.Name == TEST_LABEL_PREFIX {
+					isTestGuest = true
+					break
+				}
+			}
+		}
+
+		if !isTestGuest {
+			continue
+		}
+
+		if _, err := virtualGuestService.DeleteObject(virtualGuest.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}